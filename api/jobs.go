@@ -257,6 +257,7 @@ type UpdateStrategy struct {
 	MinHealthyTime  *time.Duration `mapstructure:"min_healthy_time"`
 	HealthyDeadline *time.Duration `mapstructure:"healthy_deadline"`
 	AutoRevert      *bool          `mapstructure:"auto_revert"`
+	AutoPromote     *bool          `mapstructure:"auto_promote"`
 	Canary          *int           `mapstructure:"canary"`
 }
 
@@ -290,6 +291,10 @@ func (u *UpdateStrategy) Copy() *UpdateStrategy {
 		copy.AutoRevert = helper.BoolToPtr(*u.AutoRevert)
 	}
 
+	if u.AutoPromote != nil {
+		copy.AutoPromote = helper.BoolToPtr(*u.AutoPromote)
+	}
+
 	if u.Canary != nil {
 		copy.Canary = helper.IntToPtr(*u.Canary)
 	}
@@ -322,6 +327,10 @@ func (u *UpdateStrategy) Merge(o *UpdateStrategy) {
 		u.AutoRevert = helper.BoolToPtr(*o.AutoRevert)
 	}
 
+	if o.AutoPromote != nil {
+		u.AutoPromote = helper.BoolToPtr(*o.AutoPromote)
+	}
+
 	if o.Canary != nil {
 		u.Canary = helper.IntToPtr(*o.Canary)
 	}
@@ -350,6 +359,10 @@ func (u *UpdateStrategy) Canonicalize() {
 		u.AutoRevert = helper.BoolToPtr(d.AutoRevert)
 	}
 
+	if u.AutoPromote == nil {
+		u.AutoPromote = helper.BoolToPtr(d.AutoPromote)
+	}
+
 	if u.Canary == nil {
 		u.Canary = helper.IntToPtr(d.Canary)
 	}
@@ -303,6 +303,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					MinHealthyTime:  helper.TimeToPtr(10 * time.Second),
 					HealthyDeadline: helper.TimeToPtr(5 * time.Minute),
 					AutoRevert:      helper.BoolToPtr(false),
+					AutoPromote:     helper.BoolToPtr(false),
 					Canary:          helper.IntToPtr(0),
 				},
 				TaskGroups: []*TaskGroup{
@@ -327,6 +328,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							MinHealthyTime:  helper.TimeToPtr(10 * time.Second),
 							HealthyDeadline: helper.TimeToPtr(5 * time.Minute),
 							AutoRevert:      helper.BoolToPtr(false),
+							AutoPromote:     helper.BoolToPtr(false),
 							Canary:          helper.IntToPtr(0),
 						},
 						Tasks: []*Task{
@@ -450,6 +452,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					MinHealthyTime:  helper.TimeToPtr(10 * time.Second),
 					HealthyDeadline: helper.TimeToPtr(6 * time.Minute),
 					AutoRevert:      helper.BoolToPtr(false),
+					AutoPromote:     helper.BoolToPtr(false),
 					Canary:          helper.IntToPtr(0),
 				},
 				TaskGroups: []*TaskGroup{
@@ -460,6 +463,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							HealthCheck:    helper.StringToPtr("manual"),
 							MinHealthyTime: helper.TimeToPtr(1 * time.Second),
 							AutoRevert:     helper.BoolToPtr(true),
+							AutoPromote:    helper.BoolToPtr(false),
 							Canary:         helper.IntToPtr(1),
 						},
 						Tasks: []*Task{
@@ -501,6 +505,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 					MinHealthyTime:  helper.TimeToPtr(10 * time.Second),
 					HealthyDeadline: helper.TimeToPtr(6 * time.Minute),
 					AutoRevert:      helper.BoolToPtr(false),
+					AutoPromote:     helper.BoolToPtr(false),
 					Canary:          helper.IntToPtr(0),
 				},
 				TaskGroups: []*TaskGroup{
@@ -524,6 +529,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							MinHealthyTime:  helper.TimeToPtr(1 * time.Second),
 							HealthyDeadline: helper.TimeToPtr(6 * time.Minute),
 							AutoRevert:      helper.BoolToPtr(true),
+							AutoPromote:     helper.BoolToPtr(false),
 							Canary:          helper.IntToPtr(1),
 						},
 						Tasks: []*Task{
@@ -555,6 +561,7 @@ func TestJobs_Canonicalize(t *testing.T) {
 							MinHealthyTime:  helper.TimeToPtr(10 * time.Second),
 							HealthyDeadline: helper.TimeToPtr(6 * time.Minute),
 							AutoRevert:      helper.BoolToPtr(false),
+							AutoPromote:     helper.BoolToPtr(false),
 							Canary:          helper.IntToPtr(0),
 						},
 						Tasks: []*Task{
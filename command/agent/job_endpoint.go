@@ -542,6 +542,7 @@ func ApiTgToStructsTG(taskGroup *api.TaskGroup, tg *structs.TaskGroup) {
 			MinHealthyTime:  *taskGroup.Update.MinHealthyTime,
 			HealthyDeadline: *taskGroup.Update.HealthyDeadline,
 			AutoRevert:      *taskGroup.Update.AutoRevert,
+			AutoPromote:     *taskGroup.Update.AutoPromote,
 			Canary:          *taskGroup.Update.Canary,
 		}
 	}
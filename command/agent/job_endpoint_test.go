@@ -909,6 +909,7 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 					MinHealthyTime:  helper.TimeToPtr(2 * time.Minute),
 					HealthyDeadline: helper.TimeToPtr(5 * time.Minute),
 					AutoRevert:      helper.BoolToPtr(true),
+					AutoPromote:     helper.BoolToPtr(true),
 				},
 
 				Meta: map[string]string{
@@ -1096,6 +1097,7 @@ func TestJobs_ApiJobToStructsJob(t *testing.T) {
 					MinHealthyTime:  2 * time.Minute,
 					HealthyDeadline: 5 * time.Minute,
 					AutoRevert:      true,
+					AutoPromote:     true,
 					Canary:          1,
 				},
 				Meta: map[string]string{
@@ -28,6 +28,11 @@ Inspect Options:
 
   -t
     Format and display evaluation using a Go template.
+
+  -update
+    Display the resolved update strategy of each task group, including any
+    defaults inherited from the job's update block. Can be combined with
+    -json or -t.
 `
 	return strings.TrimSpace(helpText)
 }
@@ -37,13 +42,14 @@ func (c *InspectCommand) Synopsis() string {
 }
 
 func (c *InspectCommand) Run(args []string) int {
-	var ojson bool
+	var ojson, update bool
 	var tmpl string
 
 	flags := c.Meta.FlagSet("inspect", FlagSetClient)
 	flags.Usage = func() { c.Ui.Output(c.Help()) }
 	flags.BoolVar(&ojson, "json", false, "")
 	flags.StringVar(&tmpl, "t", "", "")
+	flags.BoolVar(&update, "update", false, "")
 
 	if err := flags.Parse(args); err != nil {
 		return 1
@@ -139,6 +145,22 @@ func (c *InspectCommand) Run(args []string) int {
 	} else if len(tmpl) > 0 {
 		format = "template"
 	}
+
+	// Restrict the output to the update strategies if requested
+	var data interface{} = job
+	if update {
+		strategies := make(map[string]*api.UpdateStrategy, len(job.TaskGroups))
+		for _, tg := range job.TaskGroups {
+			strategies[*tg.Name] = tg.Update
+		}
+
+		if len(format) == 0 {
+			c.Ui.Output(formatUpdateStrategies(job))
+			return 0
+		}
+		data = strategies
+	}
+
 	if len(format) > 0 {
 		f, err := DataFormat(format, tmpl)
 		if err != nil {
@@ -146,7 +168,7 @@ func (c *InspectCommand) Run(args []string) int {
 			return 1
 		}
 
-		out, err := f.TransformData(job)
+		out, err := f.TransformData(data)
 		if err != nil {
 			c.Ui.Error(fmt.Sprintf("Error formatting the data: %s", err))
 			return 1
@@ -171,3 +193,29 @@ func (c *InspectCommand) Run(args []string) int {
 	c.Ui.Output(out)
 	return 0
 }
+
+// formatUpdateStrategies renders a table of each task group's update strategy.
+// Task groups without an update strategy are not updated using deployments and
+// are displayed as such.
+func formatUpdateStrategies(job *api.Job) string {
+	out := make([]string, len(job.TaskGroups)+1)
+	out[0] = "Task Group|Canary|Max Parallel|Health Check|Min Healthy Time|Healthy Deadline|Auto Revert|Auto Promote"
+	for i, tg := range job.TaskGroups {
+		u := tg.Update
+		if u == nil {
+			out[i+1] = fmt.Sprintf("%s|<none>|<none>|<none>|<none>|<none>|<none>|<none>", *tg.Name)
+			continue
+		}
+
+		out[i+1] = fmt.Sprintf("%s|%d|%d|%s|%v|%v|%v|%v",
+			*tg.Name,
+			*u.Canary,
+			*u.MaxParallel,
+			*u.HealthCheck,
+			*u.MinHealthyTime,
+			*u.HealthyDeadline,
+			*u.AutoRevert,
+			*u.AutoPromote)
+	}
+	return formatList(out)
+}
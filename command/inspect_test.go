@@ -3,7 +3,10 @@ package command
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/nomad/helper"
 	"github.com/mitchellh/cli"
 )
 
@@ -53,3 +56,31 @@ func TestInspectCommand_Fails(t *testing.T) {
 		t.Fatalf("expected getting formatter error, got: %s", out)
 	}
 }
+
+func TestInspectCommand_FormatUpdateStrategies(t *testing.T) {
+	job := testJob("job1")
+	job.TaskGroups[0].Update = &api.UpdateStrategy{
+		MaxParallel:     helper.IntToPtr(2),
+		HealthCheck:     helper.StringToPtr("checks"),
+		MinHealthyTime:  helper.TimeToPtr(10 * time.Second),
+		HealthyDeadline: helper.TimeToPtr(5 * time.Minute),
+		AutoRevert:      helper.BoolToPtr(true),
+		AutoPromote:     helper.BoolToPtr(false),
+		Canary:          helper.IntToPtr(1),
+	}
+	job.AddTaskGroup(api.NewTaskGroup("group2", 1))
+
+	out := formatUpdateStrategies(job)
+	lines := strings.Split(out, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected a header and two task groups, got: %s", out)
+	}
+	for _, expected := range []string{"group1", "checks", "10s", "5m0s", "true"} {
+		if !strings.Contains(lines[1], expected) {
+			t.Fatalf("expected %q in %q", expected, lines[1])
+		}
+	}
+	if !strings.Contains(lines[2], "group2") || !strings.Contains(lines[2], "<none>") {
+		t.Fatalf("expected group2 without an update strategy, got: %q", lines[2])
+	}
+}
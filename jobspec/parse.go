@@ -1135,6 +1135,7 @@ func parseUpdate(result **api.UpdateStrategy, list *ast.ObjectList) error {
 		"min_healthy_time",
 		"healthy_deadline",
 		"auto_revert",
+		"auto_promote",
 		"canary",
 	}
 	if err := checkHCLKeys(o.Val, valid); err != nil {
@@ -103,6 +103,7 @@ func TestParse(t *testing.T) {
 							MinHealthyTime:  helper.TimeToPtr(1 * time.Second),
 							HealthyDeadline: helper.TimeToPtr(1 * time.Minute),
 							AutoRevert:      helper.BoolToPtr(false),
+							AutoPromote:     helper.BoolToPtr(true),
 							Canary:          helper.IntToPtr(2),
 						},
 						Tasks: []*api.Task{
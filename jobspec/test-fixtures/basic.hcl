@@ -58,6 +58,7 @@ job "binstore-storagelocker" {
         min_healthy_time = "1s"
         healthy_deadline = "1m"
         auto_revert = false
+        auto_promote = true
         canary = 2
     }
 
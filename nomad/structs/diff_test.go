@@ -1509,6 +1509,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeDeleted,
 						Name: "Update",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeDeleted,
+								Name: "AutoPromote",
+								Old:  "false",
+								New:  "",
+							},
 							{
 								Type: DiffTypeDeleted,
 								Name: "AutoRevert",
@@ -1559,6 +1565,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeAdded,
 						Name: "Update",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeAdded,
+								Name: "AutoPromote",
+								Old:  "",
+								New:  "false",
+							},
 							{
 								Type: DiffTypeAdded,
 								Name: "AutoRevert",
@@ -1603,6 +1615,7 @@ func TestTaskGroupDiff(t *testing.T) {
 					MinHealthyTime:  1 * time.Second,
 					HealthyDeadline: 30 * time.Second,
 					AutoRevert:      true,
+					AutoPromote:     true,
 					Canary:          2,
 				},
 			},
@@ -1613,6 +1626,7 @@ func TestTaskGroupDiff(t *testing.T) {
 					MinHealthyTime:  2 * time.Second,
 					HealthyDeadline: 31 * time.Second,
 					AutoRevert:      false,
+					AutoPromote:     false,
 					Canary:          1,
 				},
 			},
@@ -1623,6 +1637,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "Update",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeEdited,
+								Name: "AutoPromote",
+								Old:  "true",
+								New:  "false",
+							},
 							{
 								Type: DiffTypeEdited,
 								Name: "AutoRevert",
@@ -1694,6 +1714,12 @@ func TestTaskGroupDiff(t *testing.T) {
 						Type: DiffTypeEdited,
 						Name: "Update",
 						Fields: []*FieldDiff{
+							{
+								Type: DiffTypeNone,
+								Name: "AutoPromote",
+								Old:  "false",
+								New:  "false",
+							},
 							{
 								Type: DiffTypeNone,
 								Name: "AutoRevert",
@@ -1613,6 +1613,30 @@ func (j *Job) RequiredSignals() map[string]map[string][]string {
 	return signals
 }
 
+// SpecChanged determines if the functional specification has changed between
+// two job versions. Fields that are set by Nomad rather than the submitter,
+// such as the status, stability, version and Raft indexes, are ignored.
+func (j *Job) SpecChanged(new *Job) bool {
+	if j == nil || new == nil {
+		return j != new
+	}
+
+	// Create a copy of the new job
+	c := new.Copy()
+
+	// Update the new job so we can do a reflect
+	c.Status = j.Status
+	c.StatusDescription = j.StatusDescription
+	c.Stable = j.Stable
+	c.Version = j.Version
+	c.CreateIndex = j.CreateIndex
+	c.ModifyIndex = j.ModifyIndex
+	c.JobModifyIndex = j.JobModifyIndex
+
+	// Deep equals the jobs
+	return !reflect.DeepEqual(j, c)
+}
+
 // JobListStub is used to return a subset of job information
 // for the job list
 type JobListStub struct {
@@ -1713,6 +1737,7 @@ var (
 		MinHealthyTime:  10 * time.Second,
 		HealthyDeadline: 5 * time.Minute,
 		AutoRevert:      false,
+		AutoPromote:     false,
 		Canary:          0,
 	}
 )
@@ -1744,6 +1769,10 @@ type UpdateStrategy struct {
 	// stable version.
 	AutoRevert bool
 
+	// AutoPromote declares that the deployment should be automatically
+	// promoted once all of its canaries are healthy.
+	AutoPromote bool
+
 	// Canary is the number of canaries to deploy when a change to the task
 	// group is detected.
 	Canary int
@@ -1777,6 +1806,9 @@ func (u *UpdateStrategy) Validate() error {
 	if u.Canary < 0 {
 		multierror.Append(&mErr, fmt.Errorf("Canary count can not be less than zero: %d < 0", u.Canary))
 	}
+	if u.AutoPromote && u.Canary == 0 {
+		multierror.Append(&mErr, fmt.Errorf("Auto promote requires a canary count greater than zero"))
+	}
 	if u.MinHealthyTime < 0 {
 		multierror.Append(&mErr, fmt.Errorf("Minimum healthy time may not be less than zero: %v", u.MinHealthyTime))
 	}
@@ -402,6 +402,57 @@ func TestJob_IsPeriodic(t *testing.T) {
 	}
 }
 
+func TestJob_SpecChanged(t *testing.T) {
+	// Get a base test job
+	base := testJob()
+
+	// Only modify the indexes/mutable state of the job
+	mutatedBase := base.Copy()
+	mutatedBase.Status = "foo"
+	mutatedBase.Stable = true
+	mutatedBase.Version = 10
+	mutatedBase.ModifyIndex = base.ModifyIndex + 100
+	mutatedBase.JobModifyIndex = base.JobModifyIndex + 100
+
+	// changed contains a spec change that should be detected
+	change := base.Copy()
+	change.Priority = 99
+
+	cases := []struct {
+		Name     string
+		Original *Job
+		New      *Job
+		Changed  bool
+	}{
+		{
+			Name:     "Same job except mutable indexes",
+			Changed:  false,
+			Original: base,
+			New:      mutatedBase,
+		},
+		{
+			Name:     "Different",
+			Changed:  true,
+			Original: base,
+			New:      change,
+		},
+		{
+			Name:     "Nil new job",
+			Changed:  true,
+			Original: base,
+			New:      nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			if actual := c.Original.SpecChanged(c.New); actual != c.Changed {
+				t.Fatalf("SpecChanged() returned %v; want %v", actual, c.Changed)
+			}
+		})
+	}
+}
+
 func TestJob_SystemJob_Validate(t *testing.T) {
 	j := testJob()
 	j.Type = JobTypeSystem
@@ -1076,6 +1127,21 @@ func TestUpdateStrategy_Validate(t *testing.T) {
 	}
 }
 
+func TestUpdateStrategy_Validate_AutoPromote(t *testing.T) {
+	u := DefaultUpdateStrategy.Copy()
+	u.AutoPromote = true
+
+	err := u.Validate()
+	if err == nil || !strings.Contains(err.Error(), "Auto promote requires a canary count") {
+		t.Fatalf("expected auto promote error, got: %v", err)
+	}
+
+	u.Canary = 1
+	if err := u.Validate(); err != nil {
+		t.Fatalf("err: %v", err)
+	}
+}
+
 func TestResource_NetIndex(t *testing.T) {
 	r := &Resources{
 		Networks: []*NetworkResource{